@@ -26,6 +26,7 @@ import (
 	"github.com/pkg/errors"
 	"go.uber.org/multierr"
 	"go.viam.com/rdk/components/board"
+	"go.viam.com/rdk/components/encoder"
 	"go.viam.com/rdk/components/motor"
 	"go.viam.com/rdk/logging"
 	"go.viam.com/rdk/operation"
@@ -40,9 +41,26 @@ var (
 	maxRPM               = 15.0                   // max rpm of the 28byj-48 motor after gear reduction
 )
 
-// stepSequence contains switching signal for uln2003 pins.
-// Treversing through stepSequence once is one step.
-var stepSequence = [8][4]bool{
+// Recognized drive_mode config values, selecting one of the three canonical ULN2003 switching
+// sequences.
+const (
+	driveModeWave = "wave"
+	driveModeFull = "full"
+	driveModeHalf = "half"
+)
+
+// Recognized hold_mode config values, controlling what happens to the pins once the target step
+// position is reached.
+const (
+	holdModeFree      = "free"
+	holdModeHold      = "hold"
+	holdModeLastPhase = "last_phase"
+)
+
+// halfStepSequence contains the switching signal for uln2003 pins in 8-phase half-step mode.
+// Treversing through halfStepSequence once is one step. This is the default drive_mode, since it
+// gives the motor its highest resolution.
+var halfStepSequence = [][4]bool{
 	{false, false, false, true},
 	{true, false, false, true},
 	{true, false, false, false},
@@ -53,6 +71,22 @@ var stepSequence = [8][4]bool{
 	{false, false, true, true},
 }
 
+// fullStepSequence contains the switching signal for uln2003 pins in 2-phase full-step mode.
+var fullStepSequence = [][4]bool{
+	{true, true, false, false},
+	{false, true, true, false},
+	{false, false, true, true},
+	{true, false, false, true},
+}
+
+// waveStepSequence contains the switching signal for uln2003 pins in 1-phase wave-drive mode.
+var waveStepSequence = [][4]bool{
+	{true, false, false, false},
+	{false, true, false, false},
+	{false, false, true, false},
+	{false, false, false, true},
+}
+
 // PinConfig defines the mapping of where motor are wired.
 type PinConfig struct {
 	In1 string `json:"in1"`
@@ -66,6 +100,71 @@ type Config struct {
 	Pins             PinConfig `json:"pins"`
 	BoardName        string    `json:"board"`
 	TicksPerRotation int       `json:"ticks_per_rotation"`
+
+	// MaxAccelerationRPMPerSec, when set, enables a trapezoidal acceleration profile: rather than
+	// stepping at the target RPM immediately, the motor ramps speed up to cruise and back down
+	// using the classic Austin real-time stepper algorithm. Zero (the default) preserves the
+	// original instant-speed behavior.
+	MaxAccelerationRPMPerSec float64 `json:"max_acceleration_rpm_per_sec,omitempty"`
+
+	// MaxJerk bounds the rate of change of acceleration for a future S-curve profile. It is
+	// validated today but not yet consumed by the trapezoidal ramp.
+	MaxJerk float64 `json:"max_jerk,omitempty"`
+
+	// DriveMode selects the switching sequence: "wave" (1-phase), "full" (2-phase), or "half"
+	// (8-phase, the default). Wave and full step drive at half the resolution of half step; wave
+	// energizes one coil at a time and full step two.
+	// TicksPerRotation should always be calibrated for half-step (e.g. 4096 for the stock
+	// 28byj-48 gearbox); the driver automatically halves the effective ticks-per-rotation for
+	// "wave"/"full" mode, since each of their steps covers twice the rotor angle of a half step.
+	DriveMode string `json:"drive_mode,omitempty"`
+
+	// HoldMode controls what happens to the pins once the target step position is reached:
+	// "free" (the default) de-energizes the coils, "hold" keeps the last phase energized
+	// indefinitely to resist back-driving, and "last_phase" keeps the last phase energized for
+	// HoldTimeoutMs before de-energizing.
+	HoldMode string `json:"hold_mode,omitempty"`
+
+	// HoldTimeoutMs is how long, in milliseconds, to keep the last phase energized when
+	// HoldMode is "last_phase" before de-energizing. Ignored for other hold modes.
+	HoldTimeoutMs int `json:"hold_timeout_ms,omitempty"`
+
+	// HomeSwitch, if set, enables homing via Home() and the DoCommand "home" command.
+	HomeSwitch *HomeSwitchConfig `json:"home_switch,omitempty"`
+
+	// HomeRPM is the speed Home() seeks the switch at; required when HomeSwitch is set. The
+	// re-approach pass after backing off runs at a tenth of this speed for repeatability.
+	HomeRPM float64 `json:"home_rpm,omitempty"`
+
+	// HomeDirectionForward sets which direction Home() seeks the switch in. Defaults to false
+	// (backward/decreasing step position), the common orientation for a zero-position switch.
+	HomeDirectionForward bool `json:"home_direction_forward,omitempty"`
+
+	// Encoder, if set, is the name of an encoder.Encoder dependency wired to the motor shaft.
+	// When present the driver runs closed-loop: Position() reports the encoder's count instead
+	// of the commanded step position, and doRun reissues corrective steps to recover steps the
+	// 28byj-48 lost under load.
+	Encoder string `json:"encoder,omitempty"`
+
+	// EncoderTicksPerRotation is the encoder's own native ticks-per-revolution. It is almost
+	// never the same as TicksPerRotation (the stepper's configured step count), so it is
+	// required whenever Encoder is set and used to convert between encoder ticks and commanded
+	// step position.
+	EncoderTicksPerRotation int `json:"encoder_ticks_per_rotation,omitempty"`
+
+	// PositionToleranceTicks is how many stepper step-position ticks of error (after converting
+	// the encoder's reading via EncoderTicksPerRotation) to tolerate before IsMoving/GoTo
+	// consider the target reached and before doRun issues a corrective step. Only used when
+	// Encoder is set.
+	PositionToleranceTicks int `json:"position_tolerance_ticks,omitempty"`
+}
+
+// HomeSwitchConfig describes a GPIO-connected limit switch used for homing.
+type HomeSwitchConfig struct {
+	Pin string `json:"pin"`
+	// ActiveHigh indicates whether the switch reads logic-high when triggered. Defaults to false
+	// (active-low), the common wiring for a normally-open switch pulled up to the rail.
+	ActiveHigh bool `json:"active_high,omitempty"`
 }
 
 // Validate ensures all parts of the config are valid.
@@ -91,10 +190,71 @@ func (conf *Config) Validate(path string) ([]string, error) {
 		return nil, resource.NewConfigValidationFieldRequiredError(path, "in4")
 	}
 
+	if conf.MaxAccelerationRPMPerSec < 0 {
+		return nil, errors.New("max_acceleration_rpm_per_sec cannot be negative")
+	}
+
+	if conf.MaxJerk < 0 {
+		return nil, errors.New("max_jerk cannot be negative")
+	}
+
+	switch conf.DriveMode {
+	case "", driveModeWave, driveModeFull, driveModeHalf:
+	default:
+		return nil, errors.Errorf("invalid drive_mode %q: must be one of %q, %q, %q", conf.DriveMode, driveModeWave, driveModeFull, driveModeHalf)
+	}
+
+	switch conf.HoldMode {
+	case "", holdModeFree, holdModeHold, holdModeLastPhase:
+	default:
+		return nil, errors.Errorf("invalid hold_mode %q: must be one of %q, %q, %q", conf.HoldMode, holdModeFree, holdModeHold, holdModeLastPhase)
+	}
+
+	if conf.HoldTimeoutMs < 0 {
+		return nil, errors.New("hold_timeout_ms cannot be negative")
+	}
+
+	if conf.HoldMode == holdModeLastPhase && conf.HoldTimeoutMs <= 0 {
+		return nil, errors.New("hold_timeout_ms must be greater than zero when hold_mode is \"last_phase\"")
+	}
+
+	if conf.HomeSwitch != nil {
+		if conf.HomeSwitch.Pin == "" {
+			return nil, resource.NewConfigValidationFieldRequiredError(path, "home_switch.pin")
+		}
+		if conf.HomeRPM <= 0 {
+			return nil, errors.New("home_rpm must be greater than zero when home_switch is configured")
+		}
+	}
+
+	if conf.PositionToleranceTicks < 0 {
+		return nil, errors.New("position_tolerance_ticks cannot be negative")
+	}
+
+	if conf.Encoder != "" && conf.EncoderTicksPerRotation <= 0 {
+		return nil, resource.NewConfigValidationFieldRequiredError(path, "encoder_ticks_per_rotation")
+	}
+
 	deps = append(deps, conf.BoardName)
+	if conf.Encoder != "" {
+		deps = append(deps, conf.Encoder)
+	}
 	return deps, nil
 }
 
+// stepSequenceFor returns the switching sequence for a drive_mode config value, defaulting to
+// half-step.
+func stepSequenceFor(driveMode string) [][4]bool {
+	switch driveMode {
+	case driveModeWave:
+		return waveStepSequence
+	case driveModeFull:
+		return fullStepSequence
+	default:
+		return halfStepSequence
+	}
+}
+
 func init() {
 	resource.RegisterComponent(motor.API, Model, resource.Registration[motor.Motor, *Config]{
 		Constructor: new28byj,
@@ -118,13 +278,39 @@ func new28byj(
 		return nil, errors.New("expected ticks_per_rotation to be greater than zero in config for motor")
 	}
 
+	holdMode := mc.HoldMode
+	if holdMode == "" {
+		holdMode = holdModeFree
+	}
+
 	m := &uln28byj{
-		Named:            conf.ResourceName().AsNamed(),
-		theBoard:         b,
-		ticksPerRotation: mc.TicksPerRotation,
-		logger:           logger,
-		motorName:        conf.Name,
-		opMgr:            operation.NewSingleOperationManager(),
+		Named:                  conf.ResourceName().AsNamed(),
+		theBoard:               b,
+		ticksPerRotation:       mc.TicksPerRotation,
+		logger:                 logger,
+		motorName:              conf.Name,
+		opMgr:                  operation.NewSingleOperationManager(),
+		maxAccelRPMPerSec:      mc.MaxAccelerationRPMPerSec,
+		maxJerk:                mc.MaxJerk,
+		stepSequence:           stepSequenceFor(mc.DriveMode),
+		holdMode:               holdMode,
+		holdTimeout:            time.Duration(mc.HoldTimeoutMs) * time.Millisecond,
+		homeRPM:                mc.HomeRPM,
+		homeDirForward:         mc.HomeDirectionForward,
+		positionToleranceTicks: int64(mc.PositionToleranceTicks),
+	}
+
+	if mc.MaxJerk != 0 {
+		logger.Warnf("max_jerk is set on motor (%s) but is not yet consumed by the motion profile; ignoring", m.motorName)
+	}
+
+	if mc.Encoder != "" {
+		enc, err := encoder.FromDependencies(deps, mc.Encoder)
+		if err != nil {
+			return nil, errors.Wrapf(err, "in encoder in motor (%s)", m.motorName)
+		}
+		m.encoder = enc
+		m.encoderTicksPerRotation = float64(mc.EncoderTicksPerRotation)
 	}
 
 	in1, err := b.GPIOPinByName(mc.Pins.In1)
@@ -151,6 +337,15 @@ func new28byj(
 	}
 	m.in4 = in4
 
+	if mc.HomeSwitch != nil {
+		homeSwitch, err := b.GPIOPinByName(mc.HomeSwitch.Pin)
+		if err != nil {
+			return nil, errors.Wrapf(err, "in home_switch in motor (%s)", m.motorName)
+		}
+		m.homeSwitch = homeSwitch
+		m.homeSwitchActiveHigh = mc.HomeSwitch.ActiveHigh
+	}
+
 	return m, nil
 }
 
@@ -163,6 +358,29 @@ type uln28byj struct {
 	in1, in2, in3, in4 board.GPIOPin
 	logger             logging.Logger
 	motorName          string
+	maxAccelRPMPerSec  float64
+	maxJerk            float64 // reserved for a future jerk-limited profile; unused today
+	stepSequence       [][4]bool
+	holdMode           string
+	holdTimeout        time.Duration
+	holdTimer          *time.Timer // pending "last_phase" de-energize, canceled if a new move starts; guarded by lock
+
+	// groupOwned is set once by Group on construction for every motor it owns. A grouped motor's
+	// opMgr is bypassed by Group.runAll, so GoFor refuses to drive it standalone to avoid two
+	// independent loops racing on stepPosition/targetStepPosition.
+	groupOwned bool
+
+	homeSwitch           board.GPIOPin
+	homeSwitchActiveHigh bool
+	homeRPM              float64
+	homeDirForward       bool
+	homing               bool // true while Home() is driving through the switch; see doStep
+
+	// encoder, when configured, puts the driver in closed-loop mode: see Position, IsMoving,
+	// and the corrective stepping in doRun.
+	encoder                 encoder.Encoder
+	encoderTicksPerRotation float64
+	positionToleranceTicks  int64
 
 	// state
 	workers   *utils.StoppableWorkers
@@ -173,15 +391,102 @@ type uln28byj struct {
 	stepPosition       int64
 	stepperDelay       time.Duration
 	targetStepPosition int64
+
+	runStartPosition int64
+	profile          motionProfile
 }
 
-// doRun runs the motor till it reaches target step position.
-func (m *uln28byj) doRun() {
-	// cancel doRun if it already exists
+// motionProfile holds the precomputed trapezoidal ramp for the motor's current (or most recent)
+// run, built once by newMotionProfile when a move starts.
+type motionProfile struct {
+	active      bool
+	totalSteps  int64
+	cruiseDelay time.Duration
+	// accelDelays[i] is the per-step delay for the i-th step of acceleration, computed via the
+	// Austin real-time algorithm. The deceleration phase mirrors this slice in reverse.
+	accelDelays []time.Duration
+}
+
+// newMotionProfile computes the trapezoidal accel/cruise/decel ramp for a move of totalSteps
+// steps from rest to cruiseDelay and back, using a maximum acceleration of accelRPMPerSec
+// rpm/sec. If accelRPMPerSec is zero, ramping is disabled and the motor steps at cruiseDelay
+// throughout, matching the original behavior.
+func newMotionProfile(totalSteps int64, cruiseDelay time.Duration, accelRPMPerSec, ticksPerRotation float64) motionProfile {
+	if accelRPMPerSec <= 0 || totalSteps <= 0 {
+		return motionProfile{totalSteps: totalSteps, cruiseDelay: cruiseDelay}
+	}
+
+	accelStepsPerSec2 := accelRPMPerSec / 60.0 * ticksPerRotation
+	cruiseStepsPerSec := float64(time.Second) / float64(cruiseDelay)
+
+	accelSteps := int64(cruiseStepsPerSec * cruiseStepsPerSec / (2 * accelStepsPerSec2))
+	if accelSteps*2 > totalSteps {
+		accelSteps = totalSteps / 2
+	}
+	if accelSteps < 1 {
+		return motionProfile{totalSteps: totalSteps, cruiseDelay: cruiseDelay}
+	}
+
+	// c_0 = sqrt(2/a), the classic Austin real-time first-step delay.
+	c := time.Duration(math.Sqrt(2/accelStepsPerSec2) * float64(time.Second))
+	accelDelays := make([]time.Duration, accelSteps)
+	accelDelays[0] = c
+	for i := int64(1); i < accelSteps; i++ {
+		c = time.Duration(float64(c) * (1 - 2/(4*float64(i)+1)))
+		if c < cruiseDelay {
+			c = cruiseDelay
+		}
+		accelDelays[i] = c
+	}
+
+	return motionProfile{
+		active:      true,
+		totalSteps:  totalSteps,
+		cruiseDelay: cruiseDelay,
+		accelDelays: accelDelays,
+	}
+}
+
+// delayForStep returns the per-step delay for the stepsTaken-th step (0-indexed) of the run this
+// profile was built for, ramping up through accelDelays, cruising at cruiseDelay, then mirroring
+// accelDelays in reverse to ramp down into the final step.
+func (p motionProfile) delayForStep(stepsTaken int64) time.Duration {
+	if !p.active {
+		return p.cruiseDelay
+	}
+
+	if stepsTaken < int64(len(p.accelDelays)) {
+		return p.accelDelays[stepsTaken]
+	}
+
+	stepsFromEnd := p.totalSteps - 1 - stepsTaken
+	if stepsFromEnd >= 0 && stepsFromEnd < int64(len(p.accelDelays)) {
+		return p.accelDelays[stepsFromEnd]
+	}
+
+	return p.cruiseDelay
+}
+
+// cancelPendingRun cancels any in-flight doRun goroutine and any pending "last_phase"
+// de-energize timer left over from a previous move, so a new driver (doRun, Home, or
+// Group.runAll) can take over stepping the motor without racing the old one.
+func (m *uln28byj) cancelPendingRun() {
 	if m.doRunDone != nil {
 		m.doRunDone()
 	}
 
+	m.lock.Lock()
+	if m.holdTimer != nil {
+		m.holdTimer.Stop()
+		m.holdTimer = nil
+	}
+	m.lock.Unlock()
+}
+
+// doRun runs the motor till it reaches target step position.
+func (m *uln28byj) doRun() {
+	m.cancelPendingRun()
+
 	// start a new doRun
 	var doRunCtx context.Context
 	doRunCtx, m.doRunDone = context.WithCancel(context.Background())
@@ -193,14 +498,50 @@ func (m *uln28byj) doRun() {
 			default:
 			}
 
-			if m.getStepPosition() == m.getTargetStepPosition() {
+			reached, err := m.isPositionReached(doRunCtx)
+			if err != nil {
+				m.logger.Errorf("error reading encoder %v", err)
+				return
+			}
+
+			if reached {
 				if err := m.doStop(doRunCtx); err != nil {
 					m.logger.Errorf("error setting pins to zero %v", err)
 					return
 				}
 			} else {
-				err := m.doStep(doRunCtx, m.getStepPosition() < m.getTargetStepPosition())
-				if err != nil {
+				forward := m.getStepPosition() < m.getTargetStepPosition()
+				if m.getStepPosition() == m.getTargetStepPosition() {
+					// The commanded move finished, but the encoder says we're still short (the
+					// motor lost steps under load): extend the target by one tick in the
+					// direction that recovers the error and take a corrective step.
+					diff, err := m.encoderErrorTicks(doRunCtx)
+					if err != nil {
+						m.logger.Errorf("error reading encoder %v", err)
+						return
+					}
+					forward = diff > 0
+					if forward {
+						m.setTargetStepPosition(m.getTargetStepPosition() + 1)
+					} else {
+						m.setTargetStepPosition(m.getTargetStepPosition() - 1)
+					}
+				}
+
+				stepsTaken := abs64(m.getStepPosition() - m.getRunStartPosition())
+				m.setStepperDelay(m.getMotionProfile().delayForStep(stepsTaken))
+
+				if err := m.doStep(doRunCtx, forward); err != nil {
+					if errors.Is(err, errLimitSwitchTriggered) {
+						// The target is now permanently unreachable: sync it to the current
+						// position, as Stop() does, and de-energize/hold per hold_mode, so any
+						// in-flight GoFor's WaitForSuccess doesn't hang forever.
+						m.setTargetStepPosition(m.getStepPosition())
+						if stopErr := m.doStop(doRunCtx); stopErr != nil {
+							m.logger.Errorf("error setting pins after limit switch trigger %v", stopErr)
+						}
+						return
+					}
 					m.logger.Errorf("error stepping %v", err)
 					return
 				}
@@ -209,32 +550,79 @@ func (m *uln28byj) doRun() {
 	})
 }
 
-// doStop sets all the pins to 0 to stop the motor.
+// doStop de-energizes or holds the pins once the target step position is reached, depending on
+// hold_mode: "free" de-energizes immediately, "hold" leaves the last phase energized
+// indefinitely, and "last_phase" leaves it energized for holdTimeout before de-energizing.
 func (m *uln28byj) doStop(ctx context.Context) error {
+	m.lock.Lock()
+	holdMode := m.holdMode
+	holdTimeout := m.holdTimeout
+	m.lock.Unlock()
+
+	switch holdMode {
+	case holdModeHold:
+		return nil
+	case holdModeLastPhase:
+		if holdTimeout <= 0 {
+			break
+		}
+		m.lock.Lock()
+		if m.holdTimer != nil {
+			// Already counting down from this arrival: doRun calls doStop on every loop pass once
+			// the target is reached, and restarting the timer each time would keep it from firing.
+			m.lock.Unlock()
+			return nil
+		}
+		m.holdTimer = time.AfterFunc(holdTimeout, func() {
+			m.lock.Lock()
+			defer m.lock.Unlock()
+			m.holdTimer = nil
+			if err := m.setPins(context.Background(), [4]bool{false, false, false, false}); err != nil {
+				m.logger.Errorf("error de-energizing after hold_timeout %v", err)
+			}
+		})
+		m.lock.Unlock()
+		return nil
+	}
+
 	m.lock.Lock()
 	defer m.lock.Unlock()
 	return m.setPins(ctx, [4]bool{false, false, false, false})
 }
 
+// errLimitSwitchTriggered is returned by doStep when an active home switch stops motion outside
+// of Home(), which manages the switch itself during homing.
+var errLimitSwitchTriggered = errors.New("home switch triggered; motion stopped")
+
 // Depending on the direction, doStep will either treverse the stepSequence array in ascending
 // or descending order.
 func (m *uln28byj) doStep(ctx context.Context, forward bool) error {
 	m.lock.Lock()
 	defer m.lock.Unlock()
+
+	if m.homeSwitch != nil && !m.homing {
+		active, err := m.isHomeSwitchActive(ctx)
+		if err != nil {
+			return err
+		}
+		if active {
+			return errLimitSwitchTriggered
+		}
+	}
+
 	if forward {
 		m.stepPosition++
 	} else {
 		m.stepPosition--
 	}
 
-	var nextStepSequence int
-	if m.stepPosition < 0 {
-		nextStepSequence = 7 + int(m.stepPosition%8)
-	} else {
-		nextStepSequence = int(m.stepPosition % 8)
+	numSteps := int64(len(m.stepSequence))
+	nextStepSequence := int(m.stepPosition % numSteps)
+	if nextStepSequence < 0 {
+		nextStepSequence += int(numSteps)
 	}
 
-	err := m.setPins(ctx, stepSequence[nextStepSequence])
+	err := m.setPins(ctx, m.stepSequence[nextStepSequence])
 	if err != nil {
 		return err
 	}
@@ -280,11 +668,52 @@ func (m *uln28byj) setStepperDelay(delay time.Duration) {
 	m.stepperDelay = delay
 }
 
+// setMotionProfile records the starting position and trapezoidal ramp for a new run, so doRun
+// can look up the correct per-step delay as the motor progresses.
+func (m *uln28byj) setMotionProfile(startPosition, targetPosition int64, cruiseDelay time.Duration) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	m.runStartPosition = startPosition
+	m.profile = newMotionProfile(abs64(targetPosition-startPosition), cruiseDelay, m.maxAccelRPMPerSec, m.stepTicksPerRotation())
+}
+
+func (m *uln28byj) getRunStartPosition() int64 {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	return m.runStartPosition
+}
+
+func (m *uln28byj) getMotionProfile() motionProfile {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	return m.profile
+}
+
+// stepTicksPerRotation returns the effective ticks-per-rotation for the motor's configured
+// drive_mode: TicksPerRotation is calibrated for the 8-phase half-step sequence, so it is scaled
+// down proportionally for the 4-phase wave/full sequences, each of whose steps covers twice the
+// rotor angle of a half step.
+func (m *uln28byj) stepTicksPerRotation() float64 {
+	return float64(m.ticksPerRotation) * float64(len(m.stepSequence)) / float64(len(halfStepSequence))
+}
+
+// abs64 returns the absolute value of an int64.
+func abs64(n int64) int64 {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
+
 // GoFor instructs the motor to go in a specific direction for a specific amount of
 // revolutions at a given speed in revolutions per minute. Both the RPM and the revolutions
 // can be assigned negative values to move in a backwards direction. Note: if both are negative
 // the motor will spin in the forward direction.
 func (m *uln28byj) GoFor(ctx context.Context, rpm, revolutions float64, extra map[string]interface{}) error {
+	if m.isGroupOwned() {
+		return errors.Errorf("motor (%s) is owned by a Group and cannot be driven standalone", m.motorName)
+	}
+
 	ctx, done := m.opMgr.New(ctx)
 	defer done()
 
@@ -302,14 +731,14 @@ func (m *uln28byj) GoFor(ctx context.Context, rpm, revolutions float64, extra ma
 		rpm = maxRPM * motor.GetSign(rpm)
 	}
 
+	startStepPosition := m.getStepPosition()
 	targetStepPosition, stepperDelay := m.goMath(rpm, revolutions)
 	m.setTargetStepPosition(targetStepPosition)
 	m.setStepperDelay(stepperDelay)
+	m.setMotionProfile(startStepPosition, targetStepPosition, stepperDelay)
 	m.doRun()
 
-	positionReached := func(ctx context.Context) (bool, error) {
-		return m.getTargetStepPosition() == m.getStepPosition(), nil
-	}
+	positionReached := m.isPositionReached
 
 	err = m.opMgr.WaitForSuccess(
 		ctx,
@@ -335,14 +764,14 @@ func (m *uln28byj) goMath(rpm, revolutions float64) (int64, time.Duration) {
 	revolutions = math.Abs(revolutions)
 	rpm = math.Abs(rpm) * float64(d)
 
-	targetPosition := m.getStepPosition() + int64(float64(d)*revolutions*float64(m.ticksPerRotation))
+	targetPosition := m.getStepPosition() + int64(float64(d)*revolutions*m.stepTicksPerRotation())
 	stepperDelay := m.calcStepperDelay(rpm)
 
 	return targetPosition, stepperDelay
 }
 
 func (m *uln28byj) calcStepperDelay(rpm float64) time.Duration {
-	stepperDelay := time.Duration(int64((1/(math.Abs(rpm)*float64(m.ticksPerRotation)/60.0))*1000000)) * time.Microsecond
+	stepperDelay := time.Duration(int64((1/(math.Abs(rpm)*m.stepTicksPerRotation()/60.0))*1000000)) * time.Microsecond
 	if stepperDelay < minDelayBetweenTicks {
 		m.logger.Debugf("Computed sleep time between ticks (%v) too short. Defaulting to %v", stepperDelay, minDelayBetweenTicks)
 		stepperDelay = minDelayBetweenTicks
@@ -377,7 +806,7 @@ func (m *uln28byj) SetRPM(ctx context.Context, rpm float64, extra map[string]int
 
 // Set the current position (+/- offset) to be the new zero (home) position.
 func (m *uln28byj) ResetZeroPosition(ctx context.Context, offset float64, extra map[string]interface{}) error {
-	newPosition := int64(-1 * offset * float64(m.ticksPerRotation))
+	newPosition := int64(-1 * offset * m.stepTicksPerRotation())
 	// use Stop to set the target position to the current position again
 	if err := m.Stop(ctx, extra); err != nil {
 		return err
@@ -391,6 +820,16 @@ func (m *uln28byj) ResetZeroPosition(ctx context.Context, offset float64, extra
 
 // SetPower is invalid for this motor.
 func (m *uln28byj) SetPower(ctx context.Context, powerPct float64, extra map[string]interface{}) error {
+	if m.isGroupOwned() {
+		return errors.Errorf("motor (%s) is owned by a Group and cannot be driven standalone", m.motorName)
+	}
+	return m.setPower(ctx, powerPct, extra)
+}
+
+// setPower is the implementation behind SetPower. It is also called directly by Group.SetPower
+// on the motors it owns, bypassing the standalone-owned guard since Group is an intentional,
+// coordinated caller rather than a racing standalone one.
+func (m *uln28byj) setPower(ctx context.Context, powerPct float64, extra map[string]interface{}) error {
 	ctx, done := m.opMgr.New(ctx)
 	defer done()
 
@@ -405,23 +844,37 @@ func (m *uln28byj) SetPower(ctx context.Context, powerPct float64, extra map[str
 	}
 
 	m.lock.Lock()
-	defer m.lock.Unlock()
+	startStepPosition := m.stepPosition
 	direction := motor.GetSign(powerPct) // get the direction to set target to -ve/+ve Inf
 	m.targetStepPosition = int64(math.Inf(int(direction)))
 	powerPct = motor.ClampPower(powerPct) // ensure 1.0 max and -1.0 min
 	m.stepperDelay = m.calcStepperDelay(powerPct * maxRPM)
+	targetStepPosition, stepperDelay := m.targetStepPosition, m.stepperDelay
+	m.lock.Unlock()
 
+	// SetPower has no finite target, so the profile only ever ramps up to cruise and holds
+	// there; it never reaches a deceleration phase until Stop cancels the run.
+	m.setMotionProfile(startStepPosition, targetStepPosition, stepperDelay)
 	m.doRun()
 
 	return nil
 }
 
-// Position reports the current step position of the motor. If it's not supported, the returned
-// data is undefined.
+// Position reports the current position of the motor, in revolutions. When an encoder is
+// configured this reports the encoder's count instead of the commanded step position, since the
+// 28byj-48 can lose steps under load.
 func (m *uln28byj) Position(ctx context.Context, extra map[string]interface{}) (float64, error) {
+	if m.encoder != nil {
+		ticks, _, err := m.encoder.Position(ctx, encoder.PositionTypeTicks, extra)
+		if err != nil {
+			return 0, err
+		}
+		return ticks / m.encoderTicksPerRotation, nil
+	}
+
 	m.lock.Lock()
 	defer m.lock.Unlock()
-	return float64(m.stepPosition) / float64(m.ticksPerRotation), nil
+	return float64(m.stepPosition) / m.stepTicksPerRotation(), nil
 }
 
 // Properties returns the status of whether the motor supports certain optional properties.
@@ -433,9 +886,11 @@ func (m *uln28byj) Properties(ctx context.Context, extra map[string]interface{})
 
 // IsMoving returns if the motor is currently moving.
 func (m *uln28byj) IsMoving(ctx context.Context) (bool, error) {
-	m.lock.Lock()
-	defer m.lock.Unlock()
-	return m.stepPosition != m.targetStepPosition, nil
+	reached, err := m.isPositionReached(ctx)
+	if err != nil {
+		return false, err
+	}
+	return !reached, nil
 }
 
 // Stop turns the power to the motor off immediately, without any gradual step down.
@@ -464,10 +919,177 @@ func (m *uln28byj) IsPowered(ctx context.Context, extra map[string]interface{})
 	return on, percent, err
 }
 
+// encoderErrorTicks returns how far the encoder's reading is from the commanded target position,
+// expressed in the stepper's own step-position units (not the encoder's native ticks, which
+// almost never share the same per-revolution resolution as the stepper): positive means the
+// motor still needs to move forward to catch up.
+func (m *uln28byj) encoderErrorTicks(ctx context.Context) (int64, error) {
+	ticks, _, err := m.encoder.Position(ctx, encoder.PositionTypeTicks, nil)
+	if err != nil {
+		return 0, err
+	}
+	encoderRevolutions := ticks / m.encoderTicksPerRotation
+	stepTicksPerRotation := m.stepTicksPerRotation()
+	targetRevolutions := float64(m.getTargetStepPosition()) / stepTicksPerRotation
+	return int64((targetRevolutions - encoderRevolutions) * stepTicksPerRotation), nil
+}
+
+// isPositionReached reports whether the motor has reached its target step position. With no
+// encoder configured this is an exact comparison against the commanded step position; with an
+// encoder it allows positionToleranceTicks of slop to account for steps lost under load.
+func (m *uln28byj) isPositionReached(ctx context.Context) (bool, error) {
+	commandedReached := m.getStepPosition() == m.getTargetStepPosition()
+	if m.encoder == nil || !commandedReached {
+		return commandedReached, nil
+	}
+
+	diff, err := m.encoderErrorTicks(ctx)
+	if err != nil {
+		return false, err
+	}
+	return abs64(diff) <= m.positionToleranceTicks, nil
+}
+
+// isHomeSwitchActive reads the home switch and returns whether it is currently triggered,
+// accounting for active_high polarity. It returns false if no home switch is configured.
+func (m *uln28byj) isHomeSwitchActive(ctx context.Context) (bool, error) {
+	if m.homeSwitch == nil {
+		return false, nil
+	}
+	val, err := m.homeSwitch.Get(ctx, nil)
+	if err != nil {
+		return false, err
+	}
+	return val == m.homeSwitchActiveHigh, nil
+}
+
+// setGroupOwned marks the motor as owned by a Group, so it refuses standalone GoFor/SetPower
+// calls that would otherwise race with Group.runAll's direct stepping.
+func (m *uln28byj) setGroupOwned() {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	m.groupOwned = true
+}
+
+func (m *uln28byj) isGroupOwned() bool {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	return m.groupOwned
+}
+
+func (m *uln28byj) setHoming(homing bool) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	m.homing = homing
+}
+
+// seekHomeSwitch steps the motor at rpm until the home switch reads the given target state,
+// bypassing doRun so Home() can drive the motor synchronously.
+func (m *uln28byj) seekHomeSwitch(ctx context.Context, rpm float64, forward, target bool) error {
+	m.setStepperDelay(m.calcStepperDelay(rpm))
+	for {
+		active, err := m.isHomeSwitchActive(ctx)
+		if err != nil {
+			return err
+		}
+		if active == target {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		default:
+		}
+
+		if err := m.doStep(ctx, forward); err != nil {
+			return err
+		}
+	}
+}
+
+// Home drives the motor toward the configured home switch at home_rpm, backs off once
+// triggered, re-approaches at a tenth of home_rpm for repeatability, and resets the current
+// position to zero. It is also reachable via DoCommand{"command": "home"}.
+func (m *uln28byj) Home(ctx context.Context) error {
+	if m.homeSwitch == nil {
+		return errors.New("home_switch is not configured for this motor")
+	}
+	if m.isGroupOwned() {
+		return errors.Errorf("motor (%s) is owned by a Group and cannot be driven standalone", m.motorName)
+	}
+
+	ctx, done := m.opMgr.New(ctx)
+	defer done()
+
+	// Home() drives the motor itself via doStep, bypassing doRun entirely: cancel any doRun
+	// goroutine (and its pending hold timer) left over from an in-flight GoFor/SetPower so it
+	// doesn't keep stepping the motor concurrently with Home's own loop.
+	m.cancelPendingRun()
+
+	m.setHoming(true)
+	defer m.setHoming(false)
+
+	if err := m.seekHomeSwitch(ctx, m.homeRPM, m.homeDirForward, true); err != nil {
+		return errors.Wrapf(err, "error seeking home switch in motor (%s)", m.motorName)
+	}
+
+	if err := m.seekHomeSwitch(ctx, m.homeRPM, !m.homeDirForward, false); err != nil {
+		return errors.Wrapf(err, "error backing off home switch in motor (%s)", m.motorName)
+	}
+
+	if err := m.seekHomeSwitch(ctx, m.homeRPM/10, m.homeDirForward, true); err != nil {
+		return errors.Wrapf(err, "error re-approaching home switch in motor (%s)", m.motorName)
+	}
+
+	if err := m.doStop(ctx); err != nil {
+		return err
+	}
+
+	if err := m.ResetZeroPosition(ctx, 0, nil); err != nil {
+		return err
+	}
+	// Position() reports the encoder when one is configured, so zero it too.
+	if m.encoder != nil {
+		return m.encoder.ResetPosition(ctx, nil)
+	}
+	return nil
+}
+
+// DoCommand supports {"command": "home"} to trigger Home().
+func (m *uln28byj) DoCommand(ctx context.Context, cmd map[string]interface{}) (map[string]interface{}, error) {
+	command, ok := cmd["command"].(string)
+	if !ok {
+		return nil, errors.New(`expected a string "command" in DoCommand`)
+	}
+
+	switch command {
+	case "home":
+		if err := m.Home(ctx); err != nil {
+			return nil, err
+		}
+		return map[string]interface{}{"command": "home", "status": "complete"}, nil
+	default:
+		return nil, errors.Errorf("unsupported command %q", command)
+	}
+}
+
 func (m *uln28byj) Close(ctx context.Context) error {
 	if err := m.Stop(ctx, nil); err != nil {
 		return err
 	}
-	m.workers.Stop()
+
+	m.lock.Lock()
+	if m.holdTimer != nil {
+		m.holdTimer.Stop()
+		m.holdTimer = nil
+	}
+	m.lock.Unlock()
+
+	// workers is only populated once doRun() has run at least once; a motor driven exclusively
+	// through a Group (which steps its motors directly, bypassing doRun) may never set it.
+	if m.workers != nil {
+		m.workers.Stop()
+	}
 	return nil
 }
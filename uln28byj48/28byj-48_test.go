@@ -0,0 +1,49 @@
+package uln28byj48
+
+import (
+	"testing"
+	"time"
+
+	"go.viam.com/test"
+)
+
+func TestStepSequenceFor(t *testing.T) {
+	test.That(t, stepSequenceFor(""), test.ShouldResemble, halfStepSequence)
+	test.That(t, stepSequenceFor(driveModeWave), test.ShouldResemble, waveStepSequence)
+	test.That(t, stepSequenceFor(driveModeFull), test.ShouldResemble, fullStepSequence)
+	test.That(t, stepSequenceFor("bogus"), test.ShouldResemble, halfStepSequence)
+}
+
+func TestNewMotionProfileNoAcceleration(t *testing.T) {
+	profile := newMotionProfile(100, 5*time.Millisecond, 0, 4096)
+	test.That(t, profile.active, test.ShouldBeFalse)
+	test.That(t, profile.delayForStep(0), test.ShouldEqual, 5*time.Millisecond)
+	test.That(t, profile.delayForStep(99), test.ShouldEqual, 5*time.Millisecond)
+}
+
+func TestNewMotionProfileRamps(t *testing.T) {
+	profile := newMotionProfile(1000, 2*time.Millisecond, 100, 4096)
+	test.That(t, profile.active, test.ShouldBeTrue)
+
+	// the first step should be slower than cruise (ramping up from rest).
+	test.That(t, profile.delayForStep(0), test.ShouldBeGreaterThan, profile.cruiseDelay)
+
+	// acceleration delays should never increase on the way to cruise (they are clamped at cruise).
+	for i := 1; i < len(profile.accelDelays); i++ {
+		test.That(t, profile.accelDelays[i], test.ShouldBeLessThanOrEqualTo, profile.accelDelays[i-1])
+	}
+
+	// the middle of the run should be cruising at cruiseDelay.
+	test.That(t, profile.delayForStep(500), test.ShouldEqual, profile.cruiseDelay)
+
+	// the ramp down should mirror the ramp up: same delay the same number of steps from each end.
+	test.That(t, profile.delayForStep(0), test.ShouldEqual, profile.delayForStep(999))
+}
+
+func TestNewMotionProfileShortMoveClampsToHalf(t *testing.T) {
+	// a move too short to reach cruise speed should still ramp up and back down symmetrically,
+	// never exceeding half the total steps spent accelerating.
+	profile := newMotionProfile(10, time.Microsecond, 100, 4096)
+	test.That(t, profile.active, test.ShouldBeTrue)
+	test.That(t, len(profile.accelDelays), test.ShouldBeLessThanOrEqualTo, 5)
+}
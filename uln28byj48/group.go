@@ -0,0 +1,329 @@
+package uln28byj48
+
+/*
+	Group coordinates two or more uln28byj motors sharing a chassis (e.g. a differential-drive
+	base) so that they step together instead of drifting apart. A single 28byj-48 is rarely useful
+	in isolation: most users wire up two or more of them and need MoveStraight/Spin/Steer
+	primitives on top, similar to the steering block found on EV3-style differential drives.
+*/
+
+import (
+	"context"
+	"math"
+	"sync"
+
+	"github.com/golang/geo/r3"
+	"github.com/pkg/errors"
+	"go.uber.org/multierr"
+	"go.viam.com/rdk/components/base"
+	"go.viam.com/rdk/components/motor"
+	"go.viam.com/rdk/logging"
+	"go.viam.com/rdk/operation"
+	"go.viam.com/rdk/resource"
+)
+
+// GroupModel is the model for a coordinated group of uln28byj 28byj-48 motors.
+var GroupModel = resource.NewModel("viam", "uln2003", "28byj-48-group")
+
+// GroupConfig describes the configuration of a group of 28byj-48 motors sharing a chassis.
+type GroupConfig struct {
+	MotorNames    []string `json:"motors"`
+	WheelRadiusMM float64  `json:"wheel_radius_mm"`
+	WheelBaseMM   float64  `json:"wheel_base_mm"`
+}
+
+// Validate ensures all parts of the config are valid.
+func (conf *GroupConfig) Validate(path string) ([]string, error) {
+	if len(conf.MotorNames) < 2 {
+		return nil, resource.NewConfigValidationError(path, errors.New("group requires at least two motors"))
+	}
+
+	if conf.WheelRadiusMM <= 0 {
+		return nil, resource.NewConfigValidationFieldRequiredError(path, "wheel_radius_mm")
+	}
+
+	if conf.WheelBaseMM <= 0 {
+		return nil, resource.NewConfigValidationFieldRequiredError(path, "wheel_base_mm")
+	}
+
+	return conf.MotorNames, nil
+}
+
+func init() {
+	resource.RegisterComponent(base.API, GroupModel, resource.Registration[base.Base, *GroupConfig]{
+		Constructor: newGroup,
+	})
+}
+
+func newGroup(
+	ctx context.Context, deps resource.Dependencies, conf resource.Config, logger logging.Logger,
+) (base.Base, error) {
+	gc, err := resource.NativeConfig[*GroupConfig](conf)
+	if err != nil {
+		return nil, err
+	}
+
+	g := &Group{
+		Named:         conf.ResourceName().AsNamed(),
+		logger:        logger,
+		groupName:     conf.Name,
+		opMgr:         operation.NewSingleOperationManager(),
+		wheelRadiusMM: gc.WheelRadiusMM,
+		wheelBaseMM:   gc.WheelBaseMM,
+	}
+
+	motors := make([]*uln28byj, 0, len(gc.MotorNames))
+	for _, name := range gc.MotorNames {
+		mtr, err := motor.FromDependencies(deps, name)
+		if err != nil {
+			return nil, errors.Wrapf(err, "in motor group (%s)", g.groupName)
+		}
+
+		um, ok := mtr.(*uln28byj)
+		if !ok {
+			return nil, errors.Errorf("motor (%s) in group (%s) is not a 28byj-48 motor managed by this module", name, g.groupName)
+		}
+		motors = append(motors, um)
+	}
+
+	// Only mark motors groupOwned once every motor in the group has resolved successfully: if an
+	// earlier name failed validation and newGroup returned an error above, the motors already
+	// seen here must not be left permanently refusing standalone GoFor/SetPower/Home with no
+	// Group actually controlling them.
+	for _, um := range motors {
+		// runAll steps motors directly, bypassing each motor's opMgr/doRun loop; mark the motor so
+		// a concurrent standalone GoFor (which does go through that loop) is refused instead of
+		// racing with runAll on stepPosition/targetStepPosition.
+		um.setGroupOwned()
+	}
+	g.motors = motors
+
+	return g, nil
+}
+
+// Group owns two or more uln28byj motors sharing a chassis and steps them in lockstep so that
+// motion primitives like MoveStraight/Spin/Steer don't drift the way independently-run motors
+// would.
+type Group struct {
+	resource.Named
+	resource.AlwaysRebuild
+	motors        []*uln28byj
+	logger        logging.Logger
+	groupName     string
+	opMgr         *operation.SingleOperationManager
+	wheelRadiusMM float64
+	wheelBaseMM   float64
+}
+
+// MoveStraight moves all motors in the group the same distance at the same speed, keeping them
+// in lockstep.
+func (g *Group) MoveStraight(ctx context.Context, distanceMm int, mmPerSec float64, extra map[string]interface{}) error {
+	revolutions, rpm := moveStraightMath(float64(distanceMm), mmPerSec, g.wheelRadiusMM)
+
+	revs := make([]float64, len(g.motors))
+	rpms := make([]float64, len(g.motors))
+	for i := range g.motors {
+		revs[i] = revolutions
+		rpms[i] = rpm
+	}
+
+	return g.runAll(ctx, revs, rpms)
+}
+
+// moveStraightMath converts a straight-line distance and speed into wheel revolutions and RPM.
+func moveStraightMath(distanceMM, mmPerSec, wheelRadiusMM float64) (revolutions, rpm float64) {
+	revolutions = distanceMM / (2 * math.Pi * wheelRadiusMM)
+	rpm = (mmPerSec * 60) / (2 * math.Pi * wheelRadiusMM)
+	return revolutions, rpm
+}
+
+// Spin rotates the group in place by angleDeg at degsPerSec, by running the first two motors in
+// opposite directions. Spin is only supported for exactly two motors, the differential-drive
+// case this primitive was designed for.
+func (g *Group) Spin(ctx context.Context, angleDeg, degsPerSec float64, extra map[string]interface{}) error {
+	if len(g.motors) != 2 {
+		return errors.New("spin is only supported for a group of exactly two motors")
+	}
+
+	revolutions, rpm := spinMath(angleDeg, degsPerSec, g.wheelBaseMM, g.wheelRadiusMM)
+
+	return g.runAll(ctx, []float64{revolutions, -revolutions}, []float64{rpm, rpm})
+}
+
+// spinMath converts an in-place rotation (angleDeg at degsPerSec) into per-wheel revolutions and
+// RPM, given the chassis's wheel base and wheel radius.
+func spinMath(angleDeg, degsPerSec, wheelBaseMM, wheelRadiusMM float64) (revolutions, rpm float64) {
+	arcLengthMM := (angleDeg / 360) * math.Pi * wheelBaseMM
+	arcSpeedMMPerSec := (degsPerSec / 360) * math.Pi * wheelBaseMM
+
+	revolutions = arcLengthMM / (2 * math.Pi * wheelRadiusMM)
+	rpm = math.Abs((arcSpeedMMPerSec * 60) / (2 * math.Pi * wheelRadiusMM))
+	return revolutions, rpm
+}
+
+// Steer drives the group using a single steering input analogous to the EV3 steering block:
+// direction ranges from -100 (full left) through 0 (straight) to 100 (full right), speed is the
+// RPM of the faster wheel, and revolutions is how far the faster wheel should travel. Steer is
+// only supported for a group of exactly two motors (left, then right).
+func (g *Group) Steer(ctx context.Context, direction int, speed, revolutions float64) error {
+	if len(g.motors) != 2 {
+		return errors.New("steer is only supported for a group of exactly two motors")
+	}
+
+	if direction < -100 || direction > 100 {
+		return errors.New("direction must be between -100 and 100")
+	}
+
+	leftSpeed, rightSpeed := steerMath(direction, speed)
+
+	// The faster wheel travels the full revolutions; the other travels in proportion to its speed,
+	// so both finish together.
+	leftRevs, rightRevs := revolutions, revolutions
+	if speed != 0 {
+		leftRevs = revolutions * math.Abs(leftSpeed/speed)
+		rightRevs = revolutions * math.Abs(rightSpeed/speed)
+	}
+
+	return g.runAll(ctx, []float64{leftRevs, rightRevs}, []float64{leftSpeed, rightSpeed})
+}
+
+// steerMath converts a -100..100 steering direction and the faster wheel's speed into the
+// left/right wheel speeds, analogous to the EV3 steering block.
+func steerMath(direction int, speed float64) (leftSpeed, rightSpeed float64) {
+	leftSpeed, rightSpeed = speed, speed
+	switch {
+	case direction > 0:
+		rightSpeed = speed * (50 - float64(direction)) / 50
+	case direction < 0:
+		leftSpeed = speed * (50 + float64(direction)) / 50
+	}
+	return leftSpeed, rightSpeed
+}
+
+// runAll moves every motor in the group the given number of revolutions at the given RPM. Each
+// motor steps on its own goroutine at its own stepperDelay/motion-profile cadence rather than a
+// shared round barrier, so motors given different speeds (as Steer does for the inner/outer
+// wheel) actually run at those speeds instead of every motor being throttled down to the slowest
+// one each round. MoveStraight and Spin give every motor the same speed, so they stay in lockstep
+// naturally. If any motor errors, every motor in the group is stopped atomically.
+func (g *Group) runAll(ctx context.Context, revolutions, rpms []float64) error {
+	ctx, done := g.opMgr.New(ctx)
+	defer done()
+
+	for i, mtr := range g.motors {
+		// Cancel any pending "last_phase" de-energize timer from a previous move: runAll steps
+		// motors directly and never goes through doRun, so nothing else cancels it, and it can
+		// otherwise fire mid-move and de-energize the pins while this goroutine is stepping.
+		mtr.cancelPendingRun()
+
+		target, stepperDelay := mtr.goMath(rpms[i], revolutions[i])
+		mtr.setTargetStepPosition(target)
+		mtr.setStepperDelay(stepperDelay)
+		mtr.setMotionProfile(mtr.getStepPosition(), target, stepperDelay)
+	}
+
+	runCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var wg sync.WaitGroup
+	errs := make([]error, len(g.motors))
+	for i, mtr := range g.motors {
+		wg.Add(1)
+		go func(i int, mtr *uln28byj) {
+			defer wg.Done()
+			for mtr.getStepPosition() != mtr.getTargetStepPosition() {
+				select {
+				case <-runCtx.Done():
+					return
+				default:
+				}
+
+				stepsTaken := abs64(mtr.getStepPosition() - mtr.getRunStartPosition())
+				mtr.setStepperDelay(mtr.getMotionProfile().delayForStep(stepsTaken))
+				if err := mtr.doStep(runCtx, mtr.getStepPosition() < mtr.getTargetStepPosition()); err != nil {
+					errs[i] = err
+					cancel()
+					return
+				}
+			}
+		}(i, mtr)
+	}
+	wg.Wait()
+
+	if err := multierr.Combine(append(errs, ctx.Err())...); err != nil {
+		return multierr.Combine(err, g.Stop(context.Background(), nil))
+	}
+
+	var stopErr error
+	for _, mtr := range g.motors {
+		stopErr = multierr.Combine(stopErr, mtr.doStop(ctx))
+	}
+	return stopErr
+}
+
+// SetPower sets each motor's power proportionally to the requested linear and angular power,
+// analogous to Steer but for indefinite motion.
+func (g *Group) SetPower(ctx context.Context, linear, angular r3.Vector, extra map[string]interface{}) error {
+	if len(g.motors) != 2 {
+		return errors.New("SetPower is only supported for a group of exactly two motors")
+	}
+
+	// Like runAll, SetPower takes the group's op: a fresh op here cancels an in-flight
+	// MoveStraight/Spin/Steer's runAll goroutines instead of racing them for the same motors.
+	ctx, done := g.opMgr.New(ctx)
+	defer done()
+
+	leftPower := linear.Y + angular.Z
+	rightPower := linear.Y - angular.Z
+
+	return multierr.Combine(
+		g.motors[0].setPower(ctx, leftPower, extra),
+		g.motors[1].setPower(ctx, rightPower, extra),
+	)
+}
+
+// SetVelocity is not supported: the 28byj-48 is an open-loop stepper and has no notion of a
+// commanded linear/angular velocity independent of SetPower or the Move* helpers above.
+func (g *Group) SetVelocity(ctx context.Context, linear, angular r3.Vector, extra map[string]interface{}) error {
+	return errors.New("SetVelocity is not supported by the 28byj-48 motor group; use MoveStraight, Spin, Steer, or SetPower")
+}
+
+// Properties returns the physical dimensions of the group's chassis.
+func (g *Group) Properties(ctx context.Context, extra map[string]interface{}) (base.Properties, error) {
+	return base.Properties{
+		TurningRadiusMeters: 0,
+		WidthMeters:         g.wheelBaseMM / 1000,
+	}, nil
+}
+
+// IsMoving returns true if any motor in the group is currently moving.
+func (g *Group) IsMoving(ctx context.Context) (bool, error) {
+	for _, mtr := range g.motors {
+		moving, err := mtr.IsMoving(ctx)
+		if err != nil {
+			return false, err
+		}
+		if moving {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// Stop stops all motors in the group, combining any errors encountered.
+func (g *Group) Stop(ctx context.Context, extra map[string]interface{}) error {
+	var err error
+	for _, mtr := range g.motors {
+		err = multierr.Combine(err, mtr.Stop(ctx, extra))
+	}
+	return err
+}
+
+// Close stops the group and closes every motor it owns.
+func (g *Group) Close(ctx context.Context) error {
+	var err error
+	for _, mtr := range g.motors {
+		err = multierr.Combine(err, mtr.Close(ctx))
+	}
+	return err
+}
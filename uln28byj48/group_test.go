@@ -0,0 +1,58 @@
+package uln28byj48
+
+import (
+	"math"
+	"testing"
+
+	"go.viam.com/test"
+)
+
+func TestMoveStraightMath(t *testing.T) {
+	wheelRadiusMM := 10.0
+	circumferenceMM := 2 * math.Pi * wheelRadiusMM
+
+	// one wheel circumference travelled in one second is one revolution at 60 RPM.
+	revolutions, rpm := moveStraightMath(circumferenceMM, circumferenceMM, wheelRadiusMM)
+	test.That(t, revolutions, test.ShouldAlmostEqual, 1.0, 0.0001)
+	test.That(t, rpm, test.ShouldAlmostEqual, 60.0, 0.0001)
+}
+
+func TestSpinMathFullCircle(t *testing.T) {
+	wheelRadiusMM := 10.0
+	wheelBaseMM := 2 * wheelRadiusMM
+
+	// a 360-degree spin traces an arc equal to the wheelbase circumference, which is one wheel
+	// revolution when the wheel and wheelbase radii match.
+	revolutions, rpm := spinMath(360, 360, wheelBaseMM, wheelRadiusMM)
+	test.That(t, revolutions, test.ShouldAlmostEqual, 1.0, 0.0001)
+	test.That(t, rpm, test.ShouldAlmostEqual, 60.0, 0.0001)
+}
+
+func TestSpinMathNegativeAngleKeepsPositiveRPM(t *testing.T) {
+	_, rpm := spinMath(-90, 90, 100, 10)
+	test.That(t, rpm, test.ShouldBeGreaterThan, 0)
+}
+
+func TestSteerMathStraight(t *testing.T) {
+	left, right := steerMath(0, 50)
+	test.That(t, left, test.ShouldEqual, 50.0)
+	test.That(t, right, test.ShouldEqual, 50.0)
+}
+
+func TestSteerMathFullRight(t *testing.T) {
+	left, right := steerMath(100, 50)
+	test.That(t, left, test.ShouldEqual, 50.0)
+	test.That(t, right, test.ShouldEqual, -50.0)
+}
+
+func TestSteerMathFullLeft(t *testing.T) {
+	left, right := steerMath(-100, 50)
+	test.That(t, left, test.ShouldEqual, -50.0)
+	test.That(t, right, test.ShouldEqual, 50.0)
+}
+
+func TestSteerMathPartialTurn(t *testing.T) {
+	left, right := steerMath(25, 50)
+	test.That(t, left, test.ShouldEqual, 50.0)
+	test.That(t, right, test.ShouldEqual, 25.0)
+}